@@ -12,11 +12,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -70,7 +73,9 @@ func ResourceDomainName() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
+								apigatewayv2.EndpointTypeEdge,
 								apigatewayv2.EndpointTypeRegional,
+								apigatewayv2.EndpointTypePrivate,
 							}, true),
 						},
 						"hosted_zone_id": {
@@ -94,9 +99,21 @@ func ResourceDomainName() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"vpc_endpoint_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
+			"policy": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+			},
 			"mutual_tls_authentication": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -105,11 +122,65 @@ func ResourceDomainName() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"truststore_uri": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
+							Computed: true,
 						},
 						"truststore_version": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
+						},
+						"generated_truststore": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"common_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"dns_names": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"key_bits": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      2048,
+										ValidateFunc: validation.IntInSlice([]int{2048, 4096}),
+									},
+									"validity_hours": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      8760,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"s3_bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"s3_key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"ca_cert_pem": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ca_private_key_pem": {
+										Type:      schema.TypeString,
+										Computed:  true,
+										Sensitive: true,
+									},
+									"truststore_s3_uri": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
 						},
 					},
 				},
@@ -118,8 +189,46 @@ func ResourceDomainName() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			resourceDomainNameCustomizeDiff,
+		),
+	}
+}
+
+// resourceDomainNameCustomizeDiff validates that mutual_tls_authentication and
+// ownership_verification_certificate_arn are only used with endpoint types that support them, and
+// that PRIVATE domain names always specify at least one VPC endpoint.
+func resourceDomainNameCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	tfList := diff.Get("domain_name_configuration").([]interface{})
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
 	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	endpointType := tfMap["endpoint_type"].(string)
+
+	switch endpointType {
+	case apigatewayv2.EndpointTypePrivate:
+		if v, ok := tfMap["vpc_endpoint_ids"].(*schema.Set); !ok || v.Len() == 0 {
+			return fmt.Errorf("domain_name_configuration.vpc_endpoint_ids must be set when endpoint_type is %s", apigatewayv2.EndpointTypePrivate)
+		}
+
+		if v, ok := tfMap["ownership_verification_certificate_arn"].(string); ok && v != "" {
+			return fmt.Errorf("domain_name_configuration.ownership_verification_certificate_arn is not supported when endpoint_type is %s", apigatewayv2.EndpointTypePrivate)
+		}
+
+		if v := diff.Get("mutual_tls_authentication").([]interface{}); len(v) > 0 {
+			return fmt.Errorf("mutual_tls_authentication is not supported when endpoint_type is %s", apigatewayv2.EndpointTypePrivate)
+		}
+	default:
+		if v, ok := tfMap["vpc_endpoint_ids"].(*schema.Set); ok && v.Len() > 0 {
+			return fmt.Errorf("domain_name_configuration.vpc_endpoint_ids is only supported when endpoint_type is %s", apigatewayv2.EndpointTypePrivate)
+		}
+	}
+
+	return nil
 }
 
 func resourceDomainNameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -129,10 +238,17 @@ func resourceDomainNameCreate(ctx context.Context, d *schema.ResourceData, meta
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	domainName := d.Get("domain_name").(string)
+
+	mutualTLSAuthentication, err := resolveMutualTLSAuthentication(ctx, d, meta, true)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "generating mutual TLS truststore for API Gateway v2 Domain Name (%s): %s", domainName, err)
+	}
+
 	input := &apigatewayv2.CreateDomainNameInput{
 		DomainName:               aws.String(domainName),
 		DomainNameConfigurations: expandDomainNameConfigurations(d.Get("domain_name_configuration").([]interface{})),
-		MutualTlsAuthentication:  expandMutualTLSAuthentication(d.Get("mutual_tls_authentication").([]interface{})),
+		MutualTlsAuthentication:  mutualTLSAuthentication,
 		Tags:                     Tags(tags.IgnoreAWS()),
 	}
 
@@ -148,6 +264,23 @@ func resourceDomainNameCreate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "waiting for API Gateway v2 Domain Name (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("policy"); ok {
+		policy, err := structure.NormalizeJsonString(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", v, err)
+		}
+
+		_, err = conn.UpdateDomainNamePolicyWithContext(ctx, &apigatewayv2.UpdateDomainNamePolicyInput{
+			DomainName: aws.String(d.Id()),
+			Policy:     aws.String(policy),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting API Gateway v2 Domain Name (%s) policy: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceDomainNameRead(ctx, d, meta)...)
 }
 
@@ -181,10 +314,33 @@ func resourceDomainNameRead(ctx context.Context, d *schema.ResourceData, meta in
 	if err := d.Set("domain_name_configuration", flattenDomainNameConfiguration(output.DomainNameConfigurations[0])); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting domain_name_configuration: %s", err)
 	}
-	if err := d.Set("mutual_tls_authentication", flattenMutualTLSAuthentication(output.MutualTlsAuthentication)); err != nil {
+	var generatedTruststore []interface{}
+	if v := d.Get("mutual_tls_authentication").([]interface{}); len(v) > 0 && v[0] != nil {
+		generatedTruststore = v[0].(map[string]interface{})["generated_truststore"].([]interface{})
+	}
+
+	if err := d.Set("mutual_tls_authentication", flattenMutualTLSAuthentication(output.MutualTlsAuthentication, generatedTruststore)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting mutual_tls_authentication: %s", err)
 	}
 
+	policy, err := FindDomainNamePolicy(ctx, conn, d.Id())
+
+	if err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 Domain Name (%s) policy: %s", d.Id(), err)
+	}
+
+	if policy != "" {
+		policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), policy)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", policy, err)
+		}
+
+		d.Set("policy", policyToSet)
+	} else {
+		d.Set("policy", "")
+	}
+
 	tags := KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -212,15 +368,23 @@ func resourceDomainNameUpdate(ctx context.Context, d *schema.ResourceData, meta
 		if d.HasChange("mutual_tls_authentication") {
 			if v, ok := d.GetOk("mutual_tls_authentication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				tfMap := v.([]interface{})[0].(map[string]interface{})
+				genList := tfMap["generated_truststore"].([]interface{})
+				regenerate := len(genList) > 0 && genList[0] != nil && generatedTruststoreInputsChanged(d)
+
+				mutualTLSAuthentication, err := resolveMutualTLSAuthentication(ctx, d, meta, regenerate)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "generating mutual TLS truststore for API Gateway v2 Domain Name (%s): %s", d.Id(), err)
+				}
 
 				input.MutualTlsAuthentication = &apigatewayv2.MutualTlsAuthenticationInput{}
 
-				if d.HasChange("mutual_tls_authentication.0.truststore_uri") {
-					input.MutualTlsAuthentication.TruststoreUri = aws.String(tfMap["truststore_uri"].(string))
+				if d.HasChange("mutual_tls_authentication.0.truststore_uri") || regenerate {
+					input.MutualTlsAuthentication.TruststoreUri = mutualTLSAuthentication.TruststoreUri
 				}
 
-				if d.HasChange("mutual_tls_authentication.0.truststore_version") {
-					input.MutualTlsAuthentication.TruststoreVersion = aws.String(tfMap["truststore_version"].(string))
+				if d.HasChange("mutual_tls_authentication.0.truststore_version") || regenerate {
+					input.MutualTlsAuthentication.TruststoreVersion = mutualTLSAuthentication.TruststoreVersion
 				}
 			} else {
 				// To disable mutual TLS for a custom domain name, remove the truststore from your custom domain name.
@@ -241,6 +405,23 @@ func resourceDomainNameUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("policy") {
+		policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", d.Get("policy").(string), err)
+		}
+
+		_, err = conn.UpdateDomainNamePolicyWithContext(ctx, &apigatewayv2.UpdateDomainNamePolicyInput{
+			DomainName: aws.String(d.Id()),
+			Policy:     aws.String(policy),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway v2 Domain Name (%s) policy: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
@@ -296,6 +477,31 @@ func FindDomainName(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, name s
 	return output, nil
 }
 
+func FindDomainNamePolicy(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, name string) (string, error) {
+	input := &apigatewayv2.GetDomainNamePolicyInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.GetDomainNamePolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.Policy), nil
+}
+
 func statusDomainName(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindDomainName(ctx, conn, name)
@@ -354,6 +560,10 @@ func expandDomainNameConfiguration(tfMap map[string]interface{}) *apigatewayv2.D
 		apiObject.OwnershipVerificationCertificateArn = aws.String(v)
 	}
 
+	if v, ok := tfMap["vpc_endpoint_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VpcEndpointIds = flex.ExpandStringSet(v)
+	}
+
 	return apiObject
 }
 
@@ -414,9 +624,54 @@ func flattenDomainNameConfiguration(apiObject *apigatewayv2.DomainNameConfigurat
 		tfMap["ownership_verification_certificate_arn"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.VpcEndpointIds; v != nil {
+		tfMap["vpc_endpoint_ids"] = flex.FlattenStringSet(v)
+	}
+
 	return []interface{}{tfMap}
 }
 
+// resolveMutualTLSAuthentication builds the MutualTlsAuthenticationInput for the configured
+// truststore. When a generated_truststore block is present and regenerate is true, it generates a
+// fresh CA key pair, uploads it to S3, and persists the computed attributes (ca_cert_pem,
+// ca_private_key_pem, truststore_s3_uri, and the resolved truststore_uri/truststore_version) back
+// to d so resourceDomainNameRead doesn't need to special-case them.
+func resolveMutualTLSAuthentication(ctx context.Context, d *schema.ResourceData, meta interface{}, regenerate bool) (*apigatewayv2.MutualTlsAuthenticationInput, error) {
+	tfList := d.Get("mutual_tls_authentication").([]interface{})
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	genList := tfMap["generated_truststore"].([]interface{})
+
+	if len(genList) == 0 || genList[0] == nil {
+		return expandMutualTLSAuthentication(tfList), nil
+	}
+
+	if regenerate {
+		generated, s3URI, versionID, err := expandGeneratedTruststore(ctx, meta, genList[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfMap["truststore_uri"] = s3URI
+		tfMap["truststore_version"] = versionID
+		tfMap["generated_truststore"] = []interface{}{generated}
+
+		if err := d.Set("mutual_tls_authentication", []interface{}{tfMap}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &apigatewayv2.MutualTlsAuthenticationInput{
+		TruststoreUri:     aws.String(tfMap["truststore_uri"].(string)),
+		TruststoreVersion: aws.String(tfMap["truststore_version"].(string)),
+	}, nil
+}
+
 func expandMutualTLSAuthentication(tfList []interface{}) *apigatewayv2.MutualTlsAuthenticationInput {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -437,7 +692,11 @@ func expandMutualTLSAuthentication(tfList []interface{}) *apigatewayv2.MutualTls
 	return apiObject
 }
 
-func flattenMutualTLSAuthentication(apiObject *apigatewayv2.MutualTlsAuthentication) []interface{} {
+// flattenMutualTLSAuthentication flattens the API response into the truststore_uri/
+// truststore_version attributes. generatedTruststore is passed through unchanged, since the CA
+// certificate and private key it holds are never returned by the API and would otherwise be lost
+// on every read.
+func flattenMutualTLSAuthentication(apiObject *apigatewayv2.MutualTlsAuthentication, generatedTruststore []interface{}) []interface{} {
 	if apiObject == nil {
 		return nil
 	}
@@ -452,5 +711,9 @@ func flattenMutualTLSAuthentication(apiObject *apigatewayv2.MutualTlsAuthenticat
 		tfMap["truststore_version"] = aws.StringValue(v)
 	}
 
+	if len(generatedTruststore) > 0 {
+		tfMap["generated_truststore"] = generatedTruststore
+	}
+
 	return []interface{}{tfMap}
 }
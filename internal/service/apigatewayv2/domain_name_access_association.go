@@ -0,0 +1,192 @@
+package apigatewayv2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_apigatewayv2_domain_name_access_association")
+func ResourceDomainNameAccessAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainNameAccessAssociationCreate,
+		ReadWithoutTimeout:   resourceDomainNameAccessAssociationRead,
+		DeleteWithoutTimeout: resourceDomainNameAccessAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_association_source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"access_association_source_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					apigatewayv2.DomainNameAccessAssociationSourceTypeVpcendpoint,
+				}, false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceDomainNameAccessAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
+
+	input := &apigatewayv2.CreateDomainNameAccessAssociationInput{
+		AccessAssociationSource:     aws.String(d.Get("access_association_source").(string)),
+		AccessAssociationSourceType: aws.String(d.Get("access_association_source_type").(string)),
+		DomainNameArn:               aws.String(d.Get("domain_name_arn").(string)),
+	}
+
+	output, err := conn.CreateDomainNameAccessAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating API Gateway v2 Domain Name Access Association: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DomainNameAccessAssociationArn))
+
+	if _, err := waitDomainNameAccessAssociationAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for API Gateway v2 Domain Name Access Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDomainNameAccessAssociationRead(ctx, d, meta)...)
+}
+
+func resourceDomainNameAccessAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
+
+	output, err := FindDomainNameAccessAssociationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] API Gateway v2 Domain Name Access Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 Domain Name Access Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_association_source", output.AccessAssociationSource)
+	d.Set("access_association_source_type", output.AccessAssociationSourceType)
+	d.Set("arn", output.DomainNameAccessAssociationArn)
+	d.Set("domain_name_arn", output.DomainNameArn)
+
+	return diags
+}
+
+func resourceDomainNameAccessAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 Domain Name Access Association: %s", d.Id())
+	_, err := conn.DeleteDomainNameAccessAssociationWithContext(ctx, &apigatewayv2.DeleteDomainNameAccessAssociationInput{
+		DomainNameAccessAssociationArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting API Gateway v2 Domain Name Access Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDomainNameAccessAssociationByARN(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, arn string) (*apigatewayv2.GetDomainNameAccessAssociationOutput, error) {
+	input := &apigatewayv2.GetDomainNameAccessAssociationInput{
+		DomainNameAccessAssociationArn: aws.String(arn),
+	}
+
+	output, err := conn.GetDomainNameAccessAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDomainNameAccessAssociation(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDomainNameAccessAssociationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, apigatewayv2.DomainNameStatusAvailable, nil
+	}
+}
+
+// waitDomainNameAccessAssociationAvailable follows the same poll-until-found pattern as
+// waitDomainNameAvailable: the association is available as soon as the GET call returns it.
+func waitDomainNameAccessAssociationAvailable(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, arn string, timeout time.Duration) (*apigatewayv2.GetDomainNameAccessAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{apigatewayv2.DomainNameStatusAvailable},
+		Refresh: statusDomainNameAccessAssociation(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*apigatewayv2.GetDomainNameAccessAssociationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
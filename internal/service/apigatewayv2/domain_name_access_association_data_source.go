@@ -0,0 +1,58 @@
+package apigatewayv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_apigatewayv2_domain_name_access_association")
+func DataSourceDomainNameAccessAssociation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDomainNameAccessAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_association_source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_association_source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"domain_name_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDomainNameAccessAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
+
+	arn := d.Get("arn").(string)
+	output, err := FindDomainNameAccessAssociationByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 Domain Name Access Association (%s): %s", arn, err)
+	}
+
+	d.SetId(aws.StringValue(output.DomainNameAccessAssociationArn))
+	d.Set("access_association_source", output.AccessAssociationSource)
+	d.Set("access_association_source_type", output.AccessAssociationSourceType)
+	d.Set("domain_name_arn", output.DomainNameArn)
+
+	return diags
+}
@@ -0,0 +1,136 @@
+package apigatewayv2
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// generateTruststoreCA creates a self-signed CA key pair suitable for use as a mutual TLS
+// truststore, PEM-encoding both the certificate and the private key.
+func generateTruststoreCA(commonName string, validityHours, keyBits int, dnsNames []string) (certPEM, keyPEM string, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, keyBits)
+	if err != nil {
+		return "", "", fmt.Errorf("generating CA private key: %w", err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", "", fmt.Errorf("generating CA serial number: %w", err)
+	}
+
+	notBefore := time.Now().Add(-time.Minute)
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(time.Duration(validityHours) * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              dnsNames,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", fmt.Errorf("creating self-signed CA certificate: %w", err)
+	}
+
+	var certBuf, keyBuf bytes.Buffer
+
+	if err := pem.Encode(&certBuf, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		return "", "", fmt.Errorf("PEM-encoding CA certificate: %w", err)
+	}
+
+	if err := pem.Encode(&keyBuf, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}); err != nil {
+		return "", "", fmt.Errorf("PEM-encoding CA private key: %w", err)
+	}
+
+	return certBuf.String(), keyBuf.String(), nil
+}
+
+// uploadTruststoreBundle uploads the PEM-encoded CA certificate to the given S3 bucket/key and
+// returns the resulting object version, which API Gateway v2 uses to detect truststore updates.
+func uploadTruststoreBundle(ctx context.Context, meta interface{}, bucket, key, certPEM string) (string, error) {
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	output, err := conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader([]byte(certPEM)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("uploading truststore to s3://%s/%s: %w", bucket, key, err)
+	}
+
+	return aws.StringValue(output.VersionId), nil
+}
+
+// expandGeneratedTruststore generates a CA key pair from the generated_truststore configuration
+// block, uploads the CA certificate to S3, and returns the computed attributes to persist back to
+// state alongside the resolved truststore_uri/truststore_version.
+func expandGeneratedTruststore(ctx context.Context, meta interface{}, tfMap map[string]interface{}) (map[string]interface{}, string, string, error) {
+	dnsNames := make([]string, 0)
+	for _, v := range tfMap["dns_names"].([]interface{}) {
+		dnsNames = append(dnsNames, v.(string))
+	}
+
+	certPEM, keyPEM, err := generateTruststoreCA(
+		tfMap["common_name"].(string),
+		tfMap["validity_hours"].(int),
+		tfMap["key_bits"].(int),
+		dnsNames,
+	)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	bucket := tfMap["s3_bucket"].(string)
+	key := tfMap["s3_key"].(string)
+
+	versionID, err := uploadTruststoreBundle(ctx, meta, bucket, key, certPEM)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	s3URI := fmt.Sprintf("s3://%s/%s", bucket, key)
+
+	generated := map[string]interface{}{
+		"common_name":        tfMap["common_name"],
+		"dns_names":          tfMap["dns_names"],
+		"key_bits":           tfMap["key_bits"],
+		"validity_hours":     tfMap["validity_hours"],
+		"s3_bucket":          bucket,
+		"s3_key":             key,
+		"ca_cert_pem":        certPEM,
+		"ca_private_key_pem": keyPEM,
+		"truststore_s3_uri":  s3URI,
+	}
+
+	return generated, s3URI, versionID, nil
+}
+
+// generatedTruststoreInputsChanged reports whether any user-supplied input of the
+// generated_truststore block changed, which determines whether the CA must be regenerated and
+// re-uploaded with a new S3 object version.
+func generatedTruststoreInputsChanged(d *schema.ResourceData) bool {
+	for _, key := range []string{"common_name", "dns_names", "key_bits", "validity_hours", "s3_bucket", "s3_key"} {
+		if d.HasChange(fmt.Sprintf("mutual_tls_authentication.0.generated_truststore.0.%s", key)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,405 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lambda_function")
+func ResourceFunction() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFunctionCreate,
+		ReadWithoutTimeout:   resourceFunctionRead,
+		UpdateWithoutTimeout: resourceFunctionUpdate,
+		DeleteWithoutTimeout: resourceFunctionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 140),
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"package_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  lambda.PackageTypeZip,
+				ValidateFunc: validation.StringInSlice([]string{
+					lambda.PackageTypeZip,
+					lambda.PackageTypeImage,
+				}, false),
+			},
+			"handler": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runtime": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filename": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"image_uri", "image_source"},
+			},
+			"image_uri": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"filename"},
+			},
+			"image_source": imageSourceSchema(),
+			"memory_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  128,
+			},
+			"timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3,
+			},
+			"qualified_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+
+	functionName := d.Get("function_name").(string)
+
+	code, err := resolveFunctionCode(ctx, d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resolving code for Lambda Function (%s): %s", functionName, err)
+	}
+
+	input := &lambda.CreateFunctionInput{
+		Code:         code,
+		FunctionName: aws.String(functionName),
+		Handler:      aws.String(d.Get("handler").(string)),
+		MemorySize:   aws.Int64(int64(d.Get("memory_size").(int))),
+		PackageType:  aws.String(d.Get("package_type").(string)),
+		Role:         aws.String(d.Get("role").(string)),
+		Runtime:      aws.String(d.Get("runtime").(string)),
+		Timeout:      aws.Int64(int64(d.Get("timeout").(int))),
+		Tags:         aws.StringMap(tags.IgnoreAWS().Map()),
+	}
+
+	output, err := conn.CreateFunctionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, err)
+	}
+
+	d.SetId(aws.StringValue(output.FunctionName))
+
+	if _, err := waitFunctionActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lambda Function (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFunctionRead(ctx, d, meta)...)
+}
+
+func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindFunctionByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Function (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s): %s", d.Id(), err)
+	}
+
+	config := output.Configuration
+	d.Set("arn", config.FunctionArn)
+	d.Set("function_name", config.FunctionName)
+	d.Set("handler", config.Handler)
+	d.Set("last_modified", config.LastModified)
+	d.Set("memory_size", config.MemorySize)
+	d.Set("package_type", config.PackageType)
+	d.Set("role", config.Role)
+	d.Set("runtime", config.Runtime)
+	d.Set("timeout", config.Timeout)
+	d.Set("version", config.Version)
+	d.Set("qualified_arn", fmt.Sprintf("%s:%s", aws.StringValue(config.FunctionArn), aws.StringValue(config.Version)))
+
+	if config.ImageConfigResponse != nil || aws.StringValue(config.PackageType) == lambda.PackageTypeImage {
+		imageURI := ""
+		if output.Code != nil {
+			imageURI = aws.StringValue(output.Code.ImageUri)
+		}
+
+		d.Set("image_uri", imageURI)
+
+		if err := d.Set("image_source", flattenImageSource(d.Get("image_source").([]interface{}), imageURI)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting image_source: %s", err)
+		}
+	}
+
+	tags := KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	if d.HasChanges("filename", "image_uri", "image_source") {
+		code, err := resolveFunctionCode(ctx, d)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "resolving code for Lambda Function (%s): %s", d.Id(), err)
+		}
+
+		input := &lambda.UpdateFunctionCodeInput{
+			FunctionName:    aws.String(d.Id()),
+			ImageUri:        code.ImageUri,
+			S3Bucket:        code.S3Bucket,
+			S3Key:           code.S3Key,
+			S3ObjectVersion: code.S3ObjectVersion,
+			ZipFile:         code.ZipFile,
+		}
+
+		if _, err := conn.UpdateFunctionCodeWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
+		}
+
+		if _, err := waitFunctionActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Lambda Function (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("handler", "memory_size", "role", "runtime", "timeout") {
+		input := &lambda.UpdateFunctionConfigurationInput{
+			FunctionName: aws.String(d.Id()),
+			Handler:      aws.String(d.Get("handler").(string)),
+			MemorySize:   aws.Int64(int64(d.Get("memory_size").(int))),
+			Role:         aws.String(d.Get("role").(string)),
+			Runtime:      aws.String(d.Get("runtime").(string)),
+			Timeout:      aws.Int64(int64(d.Get("timeout").(int))),
+		}
+
+		if _, err := conn.UpdateFunctionConfigurationWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) configuration: %s", d.Id(), err)
+		}
+
+		if _, err := waitFunctionActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Lambda Function (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFunctionRead(ctx, d, meta)...)
+}
+
+func resourceFunctionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	log.Printf("[DEBUG] Deleting Lambda Function: %s", d.Id())
+	_, err := conn.DeleteFunctionWithContext(ctx, &lambda.DeleteFunctionInput{
+		FunctionName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda Function (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resolveFunctionCode builds the lambda.FunctionCode for Create/UpdateFunctionCode, resolving
+// image_source to an immutable image_uri when configured.
+func resolveFunctionCode(ctx context.Context, d *schema.ResourceData) (*lambda.FunctionCode, error) {
+	if v, ok := d.GetOk("image_source"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		imageURI, digest, err := resolveImageURI(ctx, tfMap)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfMap["resolved_digest"] = digest
+		if err := d.Set("image_source", []interface{}{tfMap}); err != nil {
+			return nil, err
+		}
+
+		return &lambda.FunctionCode{ImageUri: aws.String(imageURI)}, nil
+	}
+
+	if v, ok := d.GetOk("image_uri"); ok && v.(string) != "" {
+		return &lambda.FunctionCode{ImageUri: aws.String(v.(string))}, nil
+	}
+
+	if v, ok := d.GetOk("filename"); ok && v.(string) != "" {
+		zip, err := os.ReadFile(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("reading filename (%s): %w", v.(string), err)
+		}
+
+		return &lambda.FunctionCode{ZipFile: zip}, nil
+	}
+
+	return nil, fmt.Errorf("one of filename, image_uri, or image_source must be configured")
+}
+
+// flattenImageSource preserves the configured image_source block across reads, updating
+// resolved_digest from the image_uri the API returned (the registry/repository/tag inputs
+// themselves aren't returned by GetFunction).
+func flattenImageSource(configured []interface{}, imageURI string) []interface{} {
+	if len(configured) == 0 || configured[0] == nil {
+		return configured
+	}
+
+	tfMap := configured[0].(map[string]interface{})
+
+	if idx := strings.Index(imageURI, "@"); idx != -1 {
+		tfMap["resolved_digest"] = imageURI[idx+1:]
+	}
+
+	return []interface{}{tfMap}
+}
+
+func FindFunctionByName(ctx context.Context, conn *lambda.Lambda, name string) (*lambda.GetFunctionOutput, error) {
+	input := &lambda.GetFunctionInput{
+		FunctionName: aws.String(name),
+	}
+
+	output, err := conn.GetFunctionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFunction(ctx context.Context, conn *lambda.Lambda, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFunctionByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Configuration.State), nil
+	}
+}
+
+func waitFunctionActive(ctx context.Context, conn *lambda.Lambda, name string, timeout time.Duration) (*lambda.GetFunctionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lambda.StatePending},
+		Target:  []string{lambda.StateActive},
+		Refresh: statusFunction(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lambda.GetFunctionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
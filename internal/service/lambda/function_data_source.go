@@ -0,0 +1,72 @@
+package lambda
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_lambda_function")
+func DataSourceFunction() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFunctionRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"image_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resolved_image_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	name := d.Get("function_name").(string)
+	output, err := FindFunctionByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Configuration.FunctionName))
+	d.Set("arn", output.Configuration.FunctionArn)
+
+	imageURI := ""
+	if output.Code != nil {
+		imageURI = aws.StringValue(output.Code.ImageUri)
+	}
+
+	d.Set("image_uri", imageURI)
+
+	if idx := strings.Index(imageURI, "@"); idx != -1 {
+		d.Set("resolved_image_digest", imageURI[idx+1:])
+	} else {
+		d.Set("resolved_image_digest", "")
+	}
+
+	return diags
+}
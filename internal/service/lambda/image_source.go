@@ -0,0 +1,224 @@
+package lambda
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// imageSourceSchema returns the image_source block wired into ResourceFunction's Schema. It lets a
+// caller reference a container image by registry/repository/tag instead of a pre-resolved
+// image_uri, with the tag pinned to an immutable digest so retagging upstream doesn't cause silent
+// drift. The digest is resolved at apply time, when the function is created or its image_source
+// changes; it is not resolved during plan, so a tag re-pushed upstream is not detected as a diff.
+func imageSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"registry": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"repository": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"tag": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"digest_pinning": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"resolved_digest": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// resolveImageURI resolves an image_source block to the image_uri to pass to
+// CreateFunction/UpdateFunctionCode, pinning digest_pinning references to an immutable
+// "repository@sha256:..." reference so retagging the upstream tag doesn't drift the function.
+func resolveImageURI(ctx context.Context, tfMap map[string]interface{}) (string, string, error) {
+	registry := tfMap["registry"].(string)
+	repository := tfMap["repository"].(string)
+	tag := tfMap["tag"].(string)
+
+	if !tfMap["digest_pinning"].(bool) {
+		return fmt.Sprintf("%s/%s:%s", registry, repository, tag), "", nil
+	}
+
+	digest, err := resolveManifestDigest(ctx, registry, repository, tag)
+
+	if err != nil {
+		return "", "", fmt.Errorf("resolving digest for %s/%s:%s: %w", registry, repository, tag, err)
+	}
+
+	return fmt.Sprintf("%s/%s@%s", registry, repository, digest), digest, nil
+}
+
+const manifestAccept = "application/vnd.oci.image.manifest.v1+json, application/vnd.oci.image.index.v1+json, application/vnd.docker.distribution.manifest.v2+json, application/vnd.docker.distribution.manifest.list.v2+json"
+
+// resolveManifestDigest queries the OCI Distribution manifest API for the digest a tag currently
+// points at, authenticating with the registry's token service when the anonymous request is
+// rejected. It prefers the registry's canonical Docker-Content-Digest response header (the
+// manifest digest); if a registry omits that header, it falls back to hashing the manifest body
+// itself with SHA-256, since the manifest digest — not the config blob digest nested inside it —
+// is what a "repository@sha256:..." reference must resolve to.
+func resolveManifestDigest(ctx context.Context, registry, repository, tag string) (string, error) {
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", registry, repository, tag)
+
+	resp, err := getManifest(ctx, manifestURL, "")
+
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		token, err := fetchBearerToken(ctx, resp.Header.Get("WWW-Authenticate"), repository)
+
+		if err != nil {
+			return "", fmt.Errorf("authenticating to registry: %w", err)
+		}
+
+		resp.Body.Close()
+
+		resp, err = getManifest(ctx, manifestURL, token)
+
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %d from registry manifest API", resp.StatusCode)
+	}
+
+	if digest := resp.Header.Get("Docker-Content-Digest"); digest != "" {
+		return digest, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return "", fmt.Errorf("reading manifest response: %w", err)
+	}
+
+	sum := sha256.Sum256(body)
+
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+func getManifest(ctx context.Context, manifestURL, bearerToken string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, manifestURL, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", manifestAccept)
+
+	if bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+
+	return http.DefaultClient.Do(req)
+}
+
+// fetchBearerToken implements the registry token auth flow described by the Docker/OCI
+// distribution spec: parse the realm/service/scope out of the 401's WWW-Authenticate header, then
+// request a (possibly anonymous, read-only) bearer token from that realm.
+func fetchBearerToken(ctx context.Context, wwwAuthenticate, repository string) (string, error) {
+	if !strings.HasPrefix(wwwAuthenticate, "Bearer ") {
+		return "", fmt.Errorf("unsupported WWW-Authenticate challenge: %s", wwwAuthenticate)
+	}
+
+	params := map[string]string{}
+
+	for _, part := range strings.Split(strings.TrimPrefix(wwwAuthenticate, "Bearer "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+
+		if len(kv) != 2 {
+			continue
+		}
+
+		params[kv[0]] = strings.Trim(kv[1], `"`)
+	}
+
+	realm, ok := params["realm"]
+
+	if !ok {
+		return "", fmt.Errorf("WWW-Authenticate challenge is missing a realm: %s", wwwAuthenticate)
+	}
+
+	q := url.Values{}
+
+	if service, ok := params["service"]; ok {
+		q.Set("service", service)
+	}
+
+	if scope, ok := params["scope"]; ok {
+		q.Set("scope", scope)
+	} else {
+		q.Set("scope", fmt.Sprintf("repository:%s:pull", repository))
+	}
+
+	tokenURL := realm + "?" + q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint %s returned status %d", realm, resp.StatusCode)
+	}
+
+	var tokenResp struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("decoding token response: %w", err)
+	}
+
+	if tokenResp.Token != "" {
+		return tokenResp.Token, nil
+	}
+
+	if tokenResp.AccessToken != "" {
+		return tokenResp.AccessToken, nil
+	}
+
+	return "", fmt.Errorf("token endpoint %s did not return a token", realm)
+}
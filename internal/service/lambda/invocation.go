@@ -0,0 +1,168 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_lambda_invocation")
+func ResourceInvocation() *schema.Resource {
+	schemaMap := map[string]*schema.Schema{
+		"function_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"qualifier": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  lambda.QualifierLatest,
+		},
+		"input": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+	}
+
+	for k, v := range invocationLifecycleSchemas() {
+		schemaMap[k] = v
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInvocationCreate,
+		ReadWithoutTimeout:   schema.NoopContext,
+		UpdateWithoutTimeout: resourceInvocationUpdate,
+		DeleteWithoutTimeout: resourceInvocationDelete,
+
+		Schema: schemaMap,
+	}
+}
+
+func resourceInvocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	result, err := invokeFunction(ctx, d, meta, invocationActionCreate)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "invoking Lambda Function (%s) on create: %s", d.Get("function_name").(string), err)
+	}
+
+	d.SetId(d.Get("function_name").(string))
+
+	if err := setInvocationResult(d, invocationActionCreate, result); err != nil {
+		return sdkdiag.AppendErrorf(diags, "storing Lambda Function (%s) create result: %s", d.Get("function_name").(string), err)
+	}
+
+	return diags
+}
+
+func resourceInvocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.Get("lifecycle_scope").(string) != invocationLifecycleScopeCRUD {
+		// Outside of CRUD lifecycle scope, any change forces a re-invocation using the plain
+		// "input" payload, same as the resource's historical create-only behavior.
+		result, err := invokeFunction(ctx, d, meta, invocationActionCreate)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "invoking Lambda Function (%s) on update: %s", d.Get("function_name").(string), err)
+		}
+
+		if err := setInvocationResult(d, invocationActionCreate, result); err != nil {
+			return sdkdiag.AppendErrorf(diags, "storing Lambda Function (%s) update result: %s", d.Get("function_name").(string), err)
+		}
+
+		return diags
+	}
+
+	result, err := invokeFunction(ctx, d, meta, invocationActionUpdate)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "invoking Lambda Function (%s) on update: %s", d.Get("function_name").(string), err)
+	}
+
+	if err := setInvocationResult(d, invocationActionUpdate, result); err != nil {
+		return sdkdiag.AppendErrorf(diags, "storing Lambda Function (%s) update result: %s", d.Get("function_name").(string), err)
+	}
+
+	return diags
+}
+
+func resourceInvocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.Get("lifecycle_scope").(string) != invocationLifecycleScopeCRUD {
+		return diags
+	}
+
+	result, err := invokeFunction(ctx, d, meta, invocationActionDestroy)
+
+	if err != nil {
+		if d.Get("ignore_failures_on_destroy").(bool) {
+			log.Printf("[WARN] ignoring failure invoking Lambda Function (%s) on destroy: %s", d.Get("function_name").(string), err)
+			return diags
+		}
+
+		return sdkdiag.AppendErrorf(diags, "invoking Lambda Function (%s) on destroy: %s", d.Get("function_name").(string), err)
+	}
+
+	if err := setInvocationResult(d, invocationActionDestroy, result); err != nil {
+		return sdkdiag.AppendErrorf(diags, "storing Lambda Function (%s) destroy result: %s", d.Get("function_name").(string), err)
+	}
+
+	return diags
+}
+
+// setInvocationResult stores an action's response in its dedicated result_create/result_update/
+// result_destroy attribute, as well as the legacy top-level "result" as the most recent response
+// across all actions, so a CRUD-scoped resource retains every action's last response instead of
+// one action's response overwriting another's.
+func setInvocationResult(d *schema.ResourceData, action, result string) error {
+	attr, err := resultAttributeForAction(action)
+
+	if err != nil {
+		return err
+	}
+
+	d.Set(attr, result)
+	d.Set("result", result)
+
+	return nil
+}
+
+func invokeFunction(ctx context.Context, d *schema.ResourceData, meta interface{}, action string) (string, error) {
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	payload, err := invocationPayloadForAction(d, action)
+
+	if err != nil {
+		return "", err
+	}
+
+	output, err := conn.InvokeWithContext(ctx, &lambda.InvokeInput{
+		FunctionName: aws.String(d.Get("function_name").(string)),
+		Qualifier:    aws.String(d.Get("qualifier").(string)),
+		Payload:      []byte(payload),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if output.FunctionError != nil {
+		return "", fmt.Errorf("%s: %s", aws.StringValue(output.FunctionError), string(output.Payload))
+	}
+
+	return string(output.Payload), nil
+}
@@ -0,0 +1,149 @@
+package lambda
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	invocationLifecycleScopeCRUD = "CRUD"
+
+	invocationActionCreate  = "create"
+	invocationActionUpdate  = "update"
+	invocationActionDestroy = "destroy"
+)
+
+// invocationLifecycleSchemas returns the additional ResourceInvocation schema attributes that
+// let a single aws_lambda_invocation fire distinct payloads on create, update, and destroy,
+// analogous to the triggers pattern used by terraform_data/null_resource.
+func invocationLifecycleSchemas() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"lifecycle_scope": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "CREATE_ONLY",
+			ValidateFunc: validation.StringInSlice([]string{
+				"CREATE_ONLY",
+				invocationLifecycleScopeCRUD,
+			}, false),
+		},
+		"input_create": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+		"input_update": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+		"input_destroy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+		"triggers": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: false,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"ignore_failures_on_destroy": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"result": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"result_create": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"result_update": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"result_destroy": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// resultAttributeForAction returns the per-action result attribute a lifecycle action's response
+// is stored in, so a CRUD-scoped resource retains the create response across later updates
+// instead of a single "result" being overwritten by every action.
+func resultAttributeForAction(action string) (string, error) {
+	switch action {
+	case invocationActionCreate:
+		return "result_create", nil
+	case invocationActionUpdate:
+		return "result_update", nil
+	case invocationActionDestroy:
+		return "result_destroy", nil
+	default:
+		return "", fmt.Errorf("unknown lifecycle action %q", action)
+	}
+}
+
+// invocationPayloadForAction builds the JSON payload to send to Lambda for the given lifecycle
+// action, injecting a "tf.action" field so the function can branch on why it was invoked. It
+// falls back to the resource's plain "input" when lifecycle_scope isn't CRUD or no action-specific
+// input was configured.
+func invocationPayloadForAction(d *schema.ResourceData, action string) (string, error) {
+	if d.Get("lifecycle_scope").(string) != invocationLifecycleScopeCRUD {
+		return d.Get("input").(string), nil
+	}
+
+	var key string
+
+	switch action {
+	case invocationActionCreate:
+		key = "input_create"
+	case invocationActionUpdate:
+		key = "input_update"
+	case invocationActionDestroy:
+		key = "input_destroy"
+	default:
+		return "", fmt.Errorf("unknown lifecycle action %q", action)
+	}
+
+	raw := d.Get(key).(string)
+
+	if raw == "" {
+		raw = d.Get("input").(string)
+	}
+
+	var decoded interface{}
+
+	if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+		return "", fmt.Errorf("parsing %s as JSON: %w", key, err)
+	}
+
+	// Only a top-level JSON object has somewhere to put "tf.action" — arrays and scalars are
+	// passed through untouched, since there's no field to inject it into.
+	payload, ok := decoded.(map[string]interface{})
+
+	if !ok {
+		return raw, nil
+	}
+
+	if _, ok := payload["tf.action"]; ok {
+		return "", fmt.Errorf("%s must not set a top-level \"tf.action\" key; it is reserved for the lifecycle action", key)
+	}
+
+	payload["tf.action"] = action
+
+	out, err := json.Marshal(payload)
+
+	if err != nil {
+		return "", fmt.Errorf("marshaling %s invocation payload: %w", key, err)
+	}
+
+	return string(out), nil
+}